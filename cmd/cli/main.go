@@ -0,0 +1,49 @@
+// Googleフォーム自動入力URL作成（CLI版）
+//
+// フォーム定義ファイルと回答データファイルから、自動入力URLをまとめて生成します。
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/yo-kondo/input_google_forms_answer/internal/batch"
+)
+
+func main() {
+	configPath := flag.String("config", "", "フォーム定義ファイル（.yaml, .yml, .json）")
+	answersPath := flag.String("answers", "", "回答データファイル（.csv, .yaml, .yml, .json）")
+	outPath := flag.String("out", "", "生成したURLの出力先ファイル（未指定時は標準出力）")
+	outDir := flag.String("out-dir", "", "生成したURLを\".url\"ショートカットとして出力するディレクトリ")
+	flag.Parse()
+
+	if *configPath == "" || *answersPath == "" {
+		fmt.Fprintln(os.Stderr, "使い方: cli -config <フォーム定義ファイル> -answers <回答データファイル> [-out <ファイル> | -out-dir <ディレクトリ>]")
+		os.Exit(2)
+	}
+
+	if err := run(*configPath, *answersPath, *outPath, *outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configPath, answersPath, outPath, outDir string) error {
+	cfg, err := batch.LoadFormConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	rows, err := batch.LoadAnswers(answersPath)
+	if err != nil {
+		return err
+	}
+
+	urls, err := batch.GenerateURLs(cfg, rows)
+	if err != nil {
+		return err
+	}
+
+	return batch.WriteURLs(os.Stdout, urls, outPath, outDir)
+}
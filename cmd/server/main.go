@@ -0,0 +1,23 @@
+// Googleフォーム自動入力URL生成のHTTP APIサーバー
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/yo-kondo/input_google_forms_answer/internal/api"
+)
+
+func main() {
+	addr := os.Getenv("ADDR")
+	if addr == "" {
+		addr = ":8080"
+	}
+
+	router := api.NewRouter()
+
+	log.Printf("サーバーを起動します: %s", addr)
+	if err := router.Run(addr); err != nil {
+		log.Fatalf("サーバーの起動に失敗しました: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package formfill
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	playform "github.com/go-playground/form/v4"
+)
+
+// Decoder は、Googleフォームの自動入力用url.Valuesを構造体タグ付きのGoの値へ変換します。
+type Decoder struct {
+	opts options
+	dec  *playform.Decoder
+}
+
+// NewDecoder は、Decoderを生成します。
+func NewDecoder(opts ...Option) *Decoder {
+	o := newOptions(opts)
+
+	dec := playform.NewDecoder()
+	dec.SetTagName(o.tagName)
+	dec.RegisterCustomTypeFunc(timeDecodeFunc(o.timeLayout), time.Time{})
+
+	return &Decoder{opts: o, dec: dec}
+}
+
+// Decode は、valuesをdstへデコードします。dstは構造体へのポインタである必要があります。
+func (d *Decoder) Decode(dst interface{}, values url.Values) error {
+	t := reflect.TypeOf(dst)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	prefixes := mapFieldPrefixes(t, d.opts.tagName)
+	err := d.dec.Decode(dst, expandMapKeys(values, prefixes))
+	return filterSidecarErrors(err, prefixes)
+}
+
+// filterSidecarErrors は、".other_option_response"のようなマップ型サイドカー値が
+// Checks []stringなど同じ質問IDのタグを持つスライス型フィールドと共存する場合に
+// go-playground/formが副次的に出す "invalid slice index" エラーを取り除きます。
+// このエラーが出てもマップ側・スライス側とも値自体は正しくデコードされているため、
+// 実害のない誤検知として扱います。
+func filterSidecarErrors(err error, prefixes []string) error {
+	decErrs, ok := err.(playform.DecodeErrors)
+	if !ok || len(prefixes) == 0 {
+		return err
+	}
+
+	prefixSet := make(map[string]bool, len(prefixes))
+	for _, p := range prefixes {
+		prefixSet[p] = true
+	}
+
+	filtered := make(playform.DecodeErrors, len(decErrs))
+	for namespace, e := range decErrs {
+		if prefixSet[namespace] && strings.Contains(e.Error(), "invalid slice index") {
+			continue
+		}
+		filtered[namespace] = e
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+// DecodeURL は、プリフィル済みのGoogleフォームURLを解析し、そのクエリ値をdstへデコードします。
+func (d *Decoder) DecodeURL(dst interface{}, rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	return d.Decode(dst, parsedURL.Query())
+}
@@ -0,0 +1,43 @@
+package formfill
+
+import (
+	"net/url"
+	"reflect"
+	"time"
+
+	playform "github.com/go-playground/form/v4"
+)
+
+// Encoder は、構造体タグ付きのGoの値をGoogleフォームの自動入力用
+// url.Valuesへ変換します。
+type Encoder struct {
+	opts options
+	enc  *playform.Encoder
+}
+
+// NewEncoder は、Encoderを生成します。
+func NewEncoder(opts ...Option) *Encoder {
+	o := newOptions(opts)
+
+	enc := playform.NewEncoder()
+	enc.SetTagName(o.tagName)
+	enc.RegisterCustomTypeFunc(timeEncodeFunc(o.timeLayout), time.Time{})
+
+	return &Encoder{opts: o, enc: enc}
+}
+
+// Encode は、vの各フィールドをタグで指定された質問IDをキーとするurl.Valuesへ変換します。
+// vは構造体、または構造体へのポインタである必要があります。
+func (e *Encoder) Encode(v interface{}) (url.Values, error) {
+	values, err := e.enc.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return collapseMapKeys(values, mapFieldPrefixes(t, e.opts.tagName)), nil
+}
@@ -0,0 +1,161 @@
+// Package formfill は、構造体タグで回答を表現したGoの型をGoogleフォームの
+// 自動入力用クエリ値（url.Values）へ変換し、またその逆変換を行います。
+//
+// フィールドには `form:"entry.917226918"` のようにGoogleフォームの質問IDを
+// タグとして指定します。内部ではgo-playground/formを利用しており、
+// ネストした構造体、チェックボックス用のスライス、".other_option_response"
+// のようなサイドカー値を表現するマップがサポートされます。
+package formfill
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+
+	playform "github.com/go-playground/form/v4"
+)
+
+// DefaultTagName は、フィールドの質問IDを指定するためのデフォルトの構造体タグ名です。
+const DefaultTagName = "form"
+
+// DefaultTimeLayout は、time.Timeフィールドを文字列へ変換する際のデフォルトの書式です。
+// "{today}"が従来yyyy-mm-dd形式で出力されていたことに合わせています。
+const DefaultTimeLayout = "2006-01-02"
+
+// Option は、EncoderまたはDecoderの設定を変更する関数です。
+type Option func(*options)
+
+type options struct {
+	tagName    string
+	timeLayout string
+}
+
+func newOptions(opts []Option) options {
+	o := options{tagName: DefaultTagName, timeLayout: DefaultTimeLayout}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithTagName は、質問IDを指定する構造体タグ名を変更します（デフォルトは"form"）。
+func WithTagName(tagName string) Option {
+	return func(o *options) { o.tagName = tagName }
+}
+
+// WithTimeLayout は、time.Timeフィールドのエンコード・デコードに使う書式を変更します。
+func WithTimeLayout(layout string) Option {
+	return func(o *options) { o.timeLayout = layout }
+}
+
+// mapFieldPrefixes は、srcが持つマップ型フィールドのネームスペース（質問IDの接頭辞）を
+// 列挙します。".other_option_response"のようなサイドカー値を、構造体タグ上では
+// ドット区切りの1つのキーとして、go-playground/form上では角括弧のマップキーとして
+// 扱うための橋渡しに使います。
+func mapFieldPrefixes(t reflect.Type, tagName string) []string {
+	var prefixes []string
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		if tag == "" {
+			tag = field.Name
+		}
+
+		switch field.Type.Kind() {
+		case reflect.Map:
+			prefixes = append(prefixes, tag)
+		case reflect.Struct:
+			if field.Type != reflect.TypeOf(time.Time{}) {
+				nested := mapFieldPrefixes(field.Type, tagName)
+				if !field.Anonymous {
+					// 名前付きのネストした構造体では、go-playground/formのネームスペースが
+					// "親のタグ.子のタグ"になるため、それに合わせます。
+					for j, p := range nested {
+						nested[j] = tag + "." + p
+					}
+				}
+				prefixes = append(prefixes, nested...)
+			}
+		}
+	}
+
+	return prefixes
+}
+
+// collapseMapKeys は、go-playground/formが出力した "prefix[key]" 形式のキーを、
+// Googleフォームが実際に使う "prefix.key" 形式へ書き換えます。
+func collapseMapKeys(values url.Values, prefixes []string) url.Values {
+	if len(prefixes) == 0 {
+		return values
+	}
+
+	collapsed := make(url.Values, len(values))
+	for key, vals := range values {
+		newKey := key
+		for _, prefix := range prefixes {
+			bracketed := prefix + "["
+			if strings.HasPrefix(key, bracketed) && strings.HasSuffix(key, "]") {
+				mapKey := key[len(bracketed) : len(key)-1]
+				newKey = prefix + "." + mapKey
+				break
+			}
+		}
+		collapsed[newKey] = append(collapsed[newKey], vals...)
+	}
+
+	return collapsed
+}
+
+// expandMapKeys は、collapseMapKeysの逆変換です。Googleフォームのプリフィル済み
+// URLが持つ "prefix.key" 形式を、go-playground/formが解釈できる "prefix[key]"
+// 形式へ戻します。
+func expandMapKeys(values url.Values, prefixes []string) url.Values {
+	if len(prefixes) == 0 {
+		return values
+	}
+
+	expanded := make(url.Values, len(values))
+	for key, vals := range values {
+		newKey := key
+		for _, prefix := range prefixes {
+			dotted := prefix + "."
+			if strings.HasPrefix(key, dotted) {
+				mapKey := key[len(dotted):]
+				if mapKey != "" && !strings.ContainsAny(mapKey, ".[") {
+					newKey = prefix + "[" + mapKey + "]"
+					break
+				}
+			}
+		}
+		expanded[newKey] = append(expanded[newKey], vals...)
+	}
+
+	return expanded
+}
+
+// timeEncodeFunc は、time.Timeフィールドを指定の書式の文字列へ変換します。
+func timeEncodeFunc(layout string) playform.EncodeCustomTypeFunc {
+	return func(v interface{}) ([]string, error) {
+		t, ok := v.(time.Time)
+		if !ok {
+			return nil, fmt.Errorf("formfill: 期待しない型です: %T", v)
+		}
+		return []string{t.Format(layout)}, nil
+	}
+}
+
+// timeDecodeFunc は、指定の書式の文字列をtime.Timeへ変換します。
+func timeDecodeFunc(layout string) playform.DecodeCustomTypeFunc {
+	return func(vals []string) (interface{}, error) {
+		if len(vals) == 0 {
+			return time.Time{}, nil
+		}
+		return time.Parse(layout, vals[0])
+	}
+}
@@ -0,0 +1,80 @@
+package formfill
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type answerSet struct {
+	Base      string            `form:"entry.917226918"`
+	Submitted time.Time         `form:"entry.59099188"`
+	Checks    []string          `form:"entry.237993201"`
+	Other     map[string]string `form:"entry.237993201"`
+}
+
+func TestEncodeDecodeRoundTrip(t *testing.T) {
+	submitted := time.Date(2026, time.July, 25, 0, 0, 0, 0, time.UTC)
+
+	in := answerSet{
+		Base:      "東京",
+		Submitted: submitted,
+		Checks:    []string{"赤", "青"},
+		Other:     map[string]string{"other_option_response": "テキスト"},
+	}
+
+	enc := NewEncoder()
+	values, err := enc.Encode(&in)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	if got := values.Get("entry.917226918"); got != "東京" {
+		t.Errorf("entry.917226918 = %q, want 東京", got)
+	}
+	if got := values.Get("entry.59099188"); got != "2026-07-25" {
+		t.Errorf("entry.59099188 = %q, want 2026-07-25", got)
+	}
+	if got := values["entry.237993201"]; !reflect.DeepEqual(got, []string{"赤", "青"}) {
+		t.Errorf("entry.237993201 = %v, want [赤 青]", got)
+	}
+	if got := values.Get("entry.237993201.other_option_response"); got != "テキスト" {
+		t.Errorf("entry.237993201.other_option_response = %q, want テキスト", got)
+	}
+
+	var out answerSet
+	dec := NewDecoder()
+	if err := dec.Decode(&out, values); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if out.Base != in.Base {
+		t.Errorf("Base = %q, want %q", out.Base, in.Base)
+	}
+	if !out.Submitted.Equal(in.Submitted) {
+		t.Errorf("Submitted = %v, want %v", out.Submitted, in.Submitted)
+	}
+	if !reflect.DeepEqual(out.Checks, in.Checks) {
+		t.Errorf("Checks = %v, want %v", out.Checks, in.Checks)
+	}
+	if !reflect.DeepEqual(out.Other, in.Other) {
+		t.Errorf("Other = %v, want %v", out.Other, in.Other)
+	}
+}
+
+func TestDecodeURL(t *testing.T) {
+	dec := NewDecoder()
+
+	var out answerSet
+	err := dec.DecodeURL(&out, "https://docs.google.com/forms/d/e/TEST/viewform?entry.917226918=%E6%9D%B1%E4%BA%AC&entry.59099188=2026-07-25&entry.237993201.other_option_response=%E3%81%9D%E3%81%AE%E4%BB%96")
+	if err != nil {
+		t.Fatalf("DecodeURL() error = %v", err)
+	}
+
+	if out.Base != "東京" {
+		t.Errorf("Base = %q, want 東京", out.Base)
+	}
+	if out.Other["other_option_response"] != "その他" {
+		t.Errorf("Other = %v, want map[other_option_response:その他]", out.Other)
+	}
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/yo-kondo/input_google_forms_answer/internal/googleforms"
+)
+
+// errMissingBase は、"base"クエリパラメータが指定されなかった場合のエラーです。
+var errMissingBase = errors.New("クエリパラメータ \"base\" は必須です")
+
+// errorResponse は、APIが返す構造化エラーレスポンスです。
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// prefillRequest は、POST /forms/prefill のリクエストボディです。
+type prefillRequest struct {
+	BaseURL string                  `json:"base_url" binding:"required"`
+	Entries []googleforms.FormEntry `json:"entries" binding:"required,dive"`
+}
+
+// prefillResponse は、自動入力URLの生成結果です。
+type prefillResponse struct {
+	EncodedURL string `json:"encoded_url"`
+	DecodedURL string `json:"decoded_url"`
+}
+
+func respondError(c *gin.Context, status int, err error) {
+	c.JSON(status, errorResponse{Error: err.Error()})
+}
+
+func buildPrefillResponse(baseURL string, entries []googleforms.FormEntry) (prefillResponse, error) {
+	encodedURL, err := googleforms.CreateAutoFillURL(baseURL, entries)
+	if err != nil {
+		return prefillResponse{}, err
+	}
+
+	decodedURL, err := url.QueryUnescape(encodedURL)
+	if err != nil {
+		// エンコードされたURLは有効なので、デコードに失敗しても処理を続行する
+		decodedURL = encodedURL
+	}
+
+	return prefillResponse{EncodedURL: encodedURL, DecodedURL: decodedURL}, nil
+}
+
+// handlePrefillJSON は、JSONボディで渡されたベースURLと入力項目から自動入力URLを生成します。
+func handlePrefillJSON(c *gin.Context) {
+	var req prefillRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	resp, err := buildPrefillResponse(req.BaseURL, req.Entries)
+	if err != nil {
+		if errors.Is(err, googleforms.ErrNotAViewformURL) {
+			respondError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// handlePrefillQuery は、"base"とGoogle自体の"entry.<id>"形式のクエリパラメータから自動入力URLを生成します。
+func handlePrefillQuery(c *gin.Context) {
+	baseURL := c.Query("base")
+	if baseURL == "" {
+		respondError(c, http.StatusBadRequest, errMissingBase)
+		return
+	}
+
+	entries := googleforms.ParseQueryEntries(c.Request.URL.Query())
+
+	resp, err := buildPrefillResponse(baseURL, entries)
+	if err != nil {
+		if errors.Is(err, googleforms.ErrNotAViewformURL) {
+			respondError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		respondError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,20 @@
+// Package api は、Googleフォーム自動入力URL生成機能をHTTP経由で公開するGinルーターを提供します。
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+)
+
+// NewRouter は、ロギングとパニックリカバリのミドルウェアを備えたGinエンジンを構築します。
+func NewRouter() *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Logger(), gin.Recovery())
+
+	forms := router.Group("/forms")
+	{
+		forms.POST("/prefill", handlePrefillJSON)
+		forms.GET("/prefill", handlePrefillQuery)
+	}
+
+	return router
+}
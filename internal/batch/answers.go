@@ -0,0 +1,80 @@
+package batch
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AnswerRow は、質問IDをキーとした1件分の回答です。
+type AnswerRow map[string]string
+
+// LoadAnswers は、拡張子（.csv, .yaml, .yml, .json）に応じて回答データファイルを読み込みます。
+//
+// CSVの場合、ヘッダー行には質問IDを列挙します（"entry."接頭辞は付けても付けなくても構いません）。
+// 以降の各行が1件の回答となり、行数分の自動入力URLが生成されます。
+func LoadAnswers(path string) ([]AnswerRow, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("回答データファイルの読み込みに失敗しました: %w", err)
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return loadAnswersCSV(f)
+	case ".yaml", ".yml":
+		var rows []AnswerRow
+		if err := yaml.NewDecoder(f).Decode(&rows); err != nil {
+			return nil, fmt.Errorf("回答データファイル(YAML)の解析に失敗しました: %w", err)
+		}
+		return rows, nil
+	case ".json":
+		var rows []AnswerRow
+		if err := json.NewDecoder(f).Decode(&rows); err != nil {
+			return nil, fmt.Errorf("回答データファイル(JSON)の解析に失敗しました: %w", err)
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("未対応の回答データファイル形式です: %s", path)
+	}
+}
+
+func loadAnswersCSV(r io.Reader) ([]AnswerRow, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("回答データファイル(CSV)のヘッダー読み込みに失敗しました: %w", err)
+	}
+	for i, col := range header {
+		header[i] = strings.TrimPrefix(strings.TrimSpace(col), "entry.")
+	}
+
+	var rows []AnswerRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("回答データファイル(CSV)の読み込みに失敗しました: %w", err)
+		}
+
+		row := make(AnswerRow, len(header))
+		for i, col := range header {
+			if i < len(record) {
+				row[col] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,130 @@
+package batch
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/yo-kondo/input_google_forms_answer/internal/googleforms"
+)
+
+func TestLoadAnswersCSV(t *testing.T) {
+	csv := "entry.1,entry.2\n東京,2026-07-25\n大阪,2026-07-26\n"
+
+	rows, err := loadAnswersCSV(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("loadAnswersCSV() error = %v", err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("len(rows) = %d, want 2", len(rows))
+	}
+	if rows[0]["1"] != "東京" || rows[0]["2"] != "2026-07-25" {
+		t.Errorf("rows[0] = %v", rows[0])
+	}
+	if rows[1]["1"] != "大阪" || rows[1]["2"] != "2026-07-26" {
+		t.Errorf("rows[1] = %v", rows[1])
+	}
+}
+
+func TestGenerateURLs(t *testing.T) {
+	cfg := FormConfig{
+		BaseURL: "https://docs.google.com/forms/d/e/TEST/viewform?usp=sf_link",
+		Questions: []Question{
+			{ID: "917226918", Comment: "所属拠点"},
+			{ID: "59099188", Comment: "日付"},
+		},
+	}
+	rows := []AnswerRow{
+		{"917226918": "東京", "59099188": "2026-07-25"},
+		{"917226918": "大阪"},
+	}
+
+	urls, err := GenerateURLs(cfg, rows)
+	if err != nil {
+		t.Fatalf("GenerateURLs() error = %v", err)
+	}
+	if len(urls) != 2 {
+		t.Fatalf("len(urls) = %d, want 2", len(urls))
+	}
+	if !strings.Contains(urls[0], "entry.917226918=") || !strings.Contains(urls[0], "entry.59099188=") {
+		t.Errorf("urls[0] = %q, missing expected entries", urls[0])
+	}
+	if strings.Contains(urls[1], "entry.59099188=") {
+		t.Errorf("urls[1] = %q, should not contain entry.59099188 (missing from row)", urls[1])
+	}
+}
+
+func TestBuildEntriesCheckbox(t *testing.T) {
+	cfg := FormConfig{
+		Questions: []Question{
+			{ID: "1", Type: "checkbox"},
+		},
+	}
+	row := AnswerRow{"1": "赤, 青"}
+
+	entries := BuildEntries(cfg, row)
+	if len(entries) != 1 {
+		t.Fatalf("len(entries) = %d, want 1", len(entries))
+	}
+	if entries[0].Type != googleforms.Checkbox {
+		t.Errorf("Type = %v, want Checkbox", entries[0].Type)
+	}
+	if want := []string{"赤", "青"}; len(entries[0].Answers) != 2 || entries[0].Answers[0] != want[0] || entries[0].Answers[1] != want[1] {
+		t.Errorf("Answers = %v, want %v", entries[0].Answers, want)
+	}
+}
+
+func TestBuildEntriesGrid(t *testing.T) {
+	cfg := FormConfig{
+		Questions: []Question{
+			{ID: "1", Type: "grid"},
+		},
+	}
+	row := AnswerRow{"1": "行1=はい; 行2=いいえ"}
+
+	entries := BuildEntries(cfg, row)
+	if len(entries) != 1 {
+		t.Fatalf("len(entries) = %d, want 1", len(entries))
+	}
+	if entries[0].Type != googleforms.Grid {
+		t.Errorf("Type = %v, want Grid", entries[0].Type)
+	}
+	if entries[0].GridAnswers["行1"] != "はい" || entries[0].GridAnswers["行2"] != "いいえ" {
+		t.Errorf("GridAnswers = %v", entries[0].GridAnswers)
+	}
+}
+
+// TestGenerateURLsChecksboxAndDate は、チェックボックスと日付の質問種別が、
+// バッチ経路（BuildEntries経由のGenerateURLs）から実際に
+// googleforms.CreateAutoFillURL の専用エンコードまで届くことを確認します。
+func TestGenerateURLsChecksboxAndDate(t *testing.T) {
+	cfg := FormConfig{
+		BaseURL: "https://docs.google.com/forms/d/e/TEST/viewform?usp=sf_link",
+		Questions: []Question{
+			{ID: "1", Type: "checkbox"},
+			{ID: "2", Type: "date"},
+		},
+	}
+	rows := []AnswerRow{
+		{"1": "赤,青", "2": "2026-07-25"},
+	}
+
+	urls, err := GenerateURLs(cfg, rows)
+	if err != nil {
+		t.Fatalf("GenerateURLs() error = %v", err)
+	}
+
+	parsed, err := url.Parse(urls[0])
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	q := parsed.Query()
+
+	if vals := q["entry.1"]; len(vals) != 2 || vals[0] != "赤" || vals[1] != "青" {
+		t.Errorf("entry.1 = %v, want [赤 青]", vals)
+	}
+	if q.Get("entry.2_year") != "2026" || q.Get("entry.2_month") != "07" || q.Get("entry.2_day") != "25" {
+		t.Errorf("date sub-keys = %v", q)
+	}
+}
@@ -0,0 +1,54 @@
+// Package batch は、フォーム定義ファイルと回答データファイルを読み込み、
+// 複数の自動入力URLをまとめて生成するためのロジックを提供します。
+package batch
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Question は、フォームの1つの質問を表す設定です。
+type Question struct {
+	ID      string `yaml:"id" json:"id"`
+	Type    string `yaml:"type" json:"type"`
+	Comment string `yaml:"comment" json:"comment"`
+}
+
+// FormConfig は、1つのGoogleフォームの定義を表す設定です。
+type FormConfig struct {
+	BaseURL   string     `yaml:"base_url" json:"base_url"`
+	Questions []Question `yaml:"questions" json:"questions"`
+}
+
+// LoadFormConfig は、拡張子（.yaml, .yml, .json）に応じてフォーム定義ファイルを読み込みます。
+func LoadFormConfig(path string) (FormConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FormConfig{}, fmt.Errorf("フォーム定義ファイルの読み込みに失敗しました: %w", err)
+	}
+
+	var cfg FormConfig
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return FormConfig{}, fmt.Errorf("フォーム定義ファイル(YAML)の解析に失敗しました: %w", err)
+		}
+	case ".json":
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return FormConfig{}, fmt.Errorf("フォーム定義ファイル(JSON)の解析に失敗しました: %w", err)
+		}
+	default:
+		return FormConfig{}, fmt.Errorf("未対応のフォーム定義ファイル形式です: %s", path)
+	}
+
+	if cfg.BaseURL == "" {
+		return FormConfig{}, fmt.Errorf("フォーム定義ファイルに base_url がありません: %s", path)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,97 @@
+package batch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yo-kondo/input_google_forms_answer/internal/googleforms"
+)
+
+// questionTypes は、フォーム定義ファイルの questions[].type に書ける文字列と、
+// googleforms.EntryType の対応です。
+var questionTypes = map[string]googleforms.EntryType{
+	"":              googleforms.Text,
+	"text":          googleforms.Text,
+	"radio":         googleforms.Radio,
+	"checkbox":      googleforms.Checkbox,
+	"date":          googleforms.Date,
+	"time":          googleforms.Time,
+	"grid":          googleforms.Grid,
+	"checkbox_grid": googleforms.CheckboxGrid,
+}
+
+// BuildEntries は、フォーム定義と1件の回答から、自動入力URL生成に使うFormEntryの一覧を組み立てます。
+// 回答データに値がない質問はスキップします。
+//
+// Checkboxはカンマ区切り（"赤,青"）で複数回答を、Grid/CheckboxGridは
+// "行ID=回答"をセミコロンで区切った形式（"行1=はい;行2=いいえ"）で
+// 行ごとの回答を表現します。
+func BuildEntries(cfg FormConfig, row AnswerRow) []googleforms.FormEntry {
+	entries := make([]googleforms.FormEntry, 0, len(cfg.Questions))
+	for _, q := range cfg.Questions {
+		answer, ok := row[q.ID]
+		if !ok || answer == "" {
+			continue
+		}
+
+		entryType := questionTypes[strings.ToLower(q.Type)]
+
+		entry := googleforms.FormEntry{
+			QuestionID: q.ID,
+			Type:       entryType,
+			Comment:    q.Comment,
+		}
+
+		switch entryType {
+		case googleforms.Checkbox:
+			entry.Answers = splitList(answer)
+		case googleforms.Grid, googleforms.CheckboxGrid:
+			entry.GridAnswers = splitGridAnswer(answer)
+		default:
+			entry.Answer = answer
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// splitList は、カンマ区切りの文字列をトリムして分割します（Checkboxの複数回答用）。
+func splitList(value string) []string {
+	parts := strings.Split(value, ",")
+	values := make([]string, 0, len(parts))
+	for _, p := range parts {
+		values = append(values, strings.TrimSpace(p))
+	}
+	return values
+}
+
+// splitGridAnswer は、"行ID=回答"をセミコロンで区切った文字列を、行IDをキーとした
+// マップへ変換します（Grid/CheckboxGridの行ごとの回答用）。
+func splitGridAnswer(value string) map[string]string {
+	answers := make(map[string]string)
+	for _, pair := range strings.Split(value, ";") {
+		row, answer, found := strings.Cut(pair, "=")
+		if !found {
+			continue
+		}
+		answers[strings.TrimSpace(row)] = strings.TrimSpace(answer)
+	}
+	return answers
+}
+
+// GenerateURLs は、フォーム定義と回答データの各行から、自動入力URLを1件ずつ生成します。
+func GenerateURLs(cfg FormConfig, rows []AnswerRow) ([]string, error) {
+	urls := make([]string, 0, len(rows))
+	for i, row := range rows {
+		entries := BuildEntries(cfg, row)
+
+		encodedURL, err := googleforms.CreateAutoFillURL(cfg.BaseURL, entries)
+		if err != nil {
+			return nil, fmt.Errorf("%d行目のURL生成に失敗しました: %w", i+1, err)
+		}
+
+		urls = append(urls, encodedURL)
+	}
+	return urls, nil
+}
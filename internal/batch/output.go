@@ -0,0 +1,55 @@
+package batch
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// WriteURLs は、生成済みの自動入力URLの一覧を出力します。
+//
+//   - outDir が指定されている場合は、1件ごとに ".url" ショートカット（Windowsの
+//     インターネットショートカット形式）を書き出します。
+//   - outPath が指定されている場合は、改行区切りでファイルに書き出します。
+//   - どちらも指定がない場合は、wに改行区切りで書き出します。
+func WriteURLs(w io.Writer, urls []string, outPath, outDir string) error {
+	switch {
+	case outDir != "":
+		return writeURLShortcuts(urls, outDir)
+	case outPath != "":
+		f, err := os.Create(outPath)
+		if err != nil {
+			return fmt.Errorf("出力ファイルの作成に失敗しました: %w", err)
+		}
+		defer f.Close()
+		return writeURLLines(f, urls)
+	default:
+		return writeURLLines(w, urls)
+	}
+}
+
+func writeURLLines(w io.Writer, urls []string) error {
+	for _, u := range urls {
+		if _, err := fmt.Fprintln(w, u); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeURLShortcuts(urls []string, outDir string) error {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return fmt.Errorf("出力ディレクトリの作成に失敗しました: %w", err)
+	}
+
+	for i, u := range urls {
+		path := filepath.Join(outDir, fmt.Sprintf("%04d.url", i+1))
+		content := fmt.Sprintf("[InternetShortcut]\r\nURL=%s\r\n", u)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			return fmt.Errorf("ショートカットファイルの書き込みに失敗しました: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package googleforms
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// scriptVarPrefix は、Googleフォームのviewform HTMLに埋め込まれる、
+// 質問定義を含むJSONデータの変数宣言の接頭辞です。
+const scriptVarPrefix = "var FB_PUBLIC_LOAD_DATA_ = "
+
+// typeCodeToEntryType は、FB_PUBLIC_LOAD_DATA_ 内の質問種別コードを
+// EntryTypeへ対応付けます。
+var typeCodeToEntryType = map[int]EntryType{
+	0:  Text,     // 記述式
+	1:  Text,     // 段落
+	2:  Radio,    // ラジオボタン
+	3:  Radio,    // プルダウン
+	4:  Checkbox, // チェックボックス
+	5:  Radio,    // 均等目盛
+	7:  Grid,     // グリッド
+	9:  Date,     // 日付
+	10: Time,     // 時刻
+}
+
+// Discover は、baseURL（viewform URL）のHTMLを取得し、埋め込まれた
+// FB_PUBLIC_LOAD_DATA_ JSONを解析して、質問IDや種別があらかじめ
+// 設定されたFormEntryのスケルトンを返します。
+//
+// 返されるFormEntryのAnswer/Answers/GridAnswersは空のままなので、
+// 利用者はChoicesを参考に値を設定してから CreateAutoFillURL に渡します。
+func Discover(baseURL string) ([]FormEntry, error) {
+	if err := ValidateBaseURL(baseURL); err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Get(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("フォームの取得に失敗しました: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("フォームの取得に失敗しました: ステータスコード %d", resp.StatusCode)
+	}
+
+	raw, err := findLoadDataScript(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []interface{}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		return nil, fmt.Errorf("FB_PUBLIC_LOAD_DATA_ の解析に失敗しました: %w", err)
+	}
+
+	return parseQuestions(data)
+}
+
+// findLoadDataScript は、HTML中から "var FB_PUBLIC_LOAD_DATA_ = ...;" を
+// 含む<script>タグを探し、JSON部分だけを取り出します。
+func findLoadDataScript(r io.Reader) (string, error) {
+	doc, err := html.Parse(r)
+	if err != nil {
+		return "", fmt.Errorf("HTMLの解析に失敗しました: %w", err)
+	}
+
+	var script string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if script != "" {
+			return
+		}
+		if n.Type == html.ElementNode && n.Data == "script" && n.FirstChild != nil {
+			text := n.FirstChild.Data
+			if strings.Contains(text, scriptVarPrefix) {
+				script = text
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	if script == "" {
+		return "", fmt.Errorf("FB_PUBLIC_LOAD_DATA_ が見つかりませんでした")
+	}
+
+	idx := strings.Index(script, scriptVarPrefix)
+	raw := strings.TrimSpace(script[idx+len(scriptVarPrefix):])
+	raw = strings.TrimSuffix(raw, ";")
+
+	return raw, nil
+}
+
+// parseQuestions は、FB_PUBLIC_LOAD_DATA_ の data[1][1] を質問定義の配列として解釈します。
+func parseQuestions(data []interface{}) ([]FormEntry, error) {
+	section, err := indexSlice(data, 1)
+	if err != nil {
+		return nil, fmt.Errorf("FB_PUBLIC_LOAD_DATA_ の形式が想定と異なります: %w", err)
+	}
+
+	questions, err := indexSlice(section, 1)
+	if err != nil {
+		return nil, fmt.Errorf("FB_PUBLIC_LOAD_DATA_ の形式が想定と異なります: %w", err)
+	}
+
+	entries := make([]FormEntry, 0, len(questions))
+	for _, q := range questions {
+		question, ok := q.([]interface{})
+		if !ok {
+			continue
+		}
+
+		entry, ok := parseQuestion(question)
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func parseQuestion(question []interface{}) (FormEntry, bool) {
+	title, _ := indexString(question, 1)
+
+	typeCode, err := indexInt(question, 3)
+	if err != nil {
+		return FormEntry{}, false
+	}
+
+	specs, err := indexSlice(question, 4)
+	if err != nil || len(specs) == 0 {
+		return FormEntry{}, false
+	}
+
+	spec, ok := specs[0].([]interface{})
+	if !ok {
+		return FormEntry{}, false
+	}
+
+	entryID, err := indexInt(spec, 0)
+	if err != nil {
+		return FormEntry{}, false
+	}
+
+	entryType, ok := typeCodeToEntryType[typeCode]
+	if !ok {
+		entryType = Text
+	}
+
+	return FormEntry{
+		QuestionID: strconv.Itoa(entryID),
+		Type:       entryType,
+		Comment:    title,
+		Choices:    parseChoices(spec),
+	}, true
+}
+
+// parseChoices は、質問のスペックに含まれる選択肢の一覧を文字列として取り出します。
+func parseChoices(spec []interface{}) []string {
+	rawChoices, err := indexSlice(spec, 1)
+	if err != nil {
+		return nil
+	}
+
+	choices := make([]string, 0, len(rawChoices))
+	for _, rc := range rawChoices {
+		row, ok := rc.([]interface{})
+		if !ok || len(row) == 0 {
+			continue
+		}
+		if label, ok := row[0].(string); ok && label != "" {
+			choices = append(choices, label)
+		}
+	}
+
+	return choices
+}
+
+func indexSlice(data []interface{}, i int) ([]interface{}, error) {
+	if i < 0 || i >= len(data) {
+		return nil, fmt.Errorf("インデックス %d が範囲外です", i)
+	}
+	s, ok := data[i].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("インデックス %d が配列ではありません", i)
+	}
+	return s, nil
+}
+
+func indexString(data []interface{}, i int) (string, error) {
+	if i < 0 || i >= len(data) {
+		return "", fmt.Errorf("インデックス %d が範囲外です", i)
+	}
+	s, ok := data[i].(string)
+	if !ok {
+		return "", fmt.Errorf("インデックス %d が文字列ではありません", i)
+	}
+	return s, nil
+}
+
+func indexInt(data []interface{}, i int) (int, error) {
+	if i < 0 || i >= len(data) {
+		return 0, fmt.Errorf("インデックス %d が範囲外です", i)
+	}
+	f, ok := data[i].(float64)
+	if !ok {
+		return 0, fmt.Errorf("インデックス %d が数値ではありません", i)
+	}
+	return int(f), nil
+}
@@ -0,0 +1,59 @@
+package googleforms
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const sampleHTML = `<!DOCTYPE html><html><body><script>
+var FB_PUBLIC_LOAD_DATA_ = [null,[null,[
+  [1111,"所属拠点",null,0,[[917226918,[],0]]],
+  [2222,"好きな色",null,4,[[237993201,[["赤"],["青"]],0]]],
+  [3333,"訪問日",null,9,[[59099188,[],0]]]
+]]];
+</script></body></html>`
+
+func TestFindLoadDataScript(t *testing.T) {
+	raw, err := findLoadDataScript(strings.NewReader(sampleHTML))
+	if err != nil {
+		t.Fatalf("findLoadDataScript() error = %v", err)
+	}
+	if !strings.HasPrefix(raw, "[null,") {
+		t.Errorf("raw = %q, want prefix [null,", raw)
+	}
+}
+
+func TestDiscoverParsesQuestions(t *testing.T) {
+	raw, err := findLoadDataScript(strings.NewReader(sampleHTML))
+	if err != nil {
+		t.Fatalf("findLoadDataScript() error = %v", err)
+	}
+
+	var data []interface{}
+	if err := json.Unmarshal([]byte(raw), &data); err != nil {
+		t.Fatalf("unmarshal error = %v", err)
+	}
+
+	entries, err := parseQuestions(data)
+	if err != nil {
+		t.Fatalf("parseQuestions() error = %v", err)
+	}
+
+	if len(entries) != 3 {
+		t.Fatalf("len(entries) = %d, want 3", len(entries))
+	}
+
+	if entries[0].QuestionID != "917226918" || entries[0].Type != Text || entries[0].Comment != "所属拠点" {
+		t.Errorf("entries[0] = %+v", entries[0])
+	}
+	if entries[1].QuestionID != "237993201" || entries[1].Type != Checkbox {
+		t.Errorf("entries[1] = %+v", entries[1])
+	}
+	if want := []string{"赤", "青"}; len(entries[1].Choices) != 2 || entries[1].Choices[0] != want[0] || entries[1].Choices[1] != want[1] {
+		t.Errorf("entries[1].Choices = %v, want %v", entries[1].Choices, want)
+	}
+	if entries[2].QuestionID != "59099188" || entries[2].Type != Date {
+		t.Errorf("entries[2] = %+v", entries[2])
+	}
+}
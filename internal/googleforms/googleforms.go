@@ -0,0 +1,363 @@
+// Package googleforms は、Googleフォームの自動入力URLを生成するためのロジックを提供します。
+package googleforms
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// EntryType は、質問の種類を表します。種類によって自動入力URLのパラメータの
+// 組み立て方（単一値かリピートか、サブキーに分割するか）が変わります。
+type EntryType int
+
+const (
+	// Text は、記述式・段落など、単一の文字列で回答する質問です。
+	Text EntryType = iota
+	// Radio は、ラジオボタン・プルダウン・均等目盛など、選択肢から1つを選ぶ質問です。
+	// Textと同様に単一の値を書き込みます。
+	Radio
+	// Checkbox は、チェックボックスの質問です。Answersの各値を
+	// 同じentry.<id>パラメータとして繰り返し書き込みます。
+	Checkbox
+	// Date は、日付の質問です。AnswerをYYYY-MM-DD形式として解釈し、
+	// entry.<id>_year/_month/_dayに分割します。
+	Date
+	// Time は、時刻の質問です。AnswerをHH:MM形式として解釈し、
+	// entry.<id>_hour/_minuteに分割します。
+	Time
+	// Grid は、選択式（グリッド）の質問です。GridAnswersの行ID→回答を
+	// entry.<id>.<row>として書き込みます。
+	Grid
+	// CheckboxGrid は、チェックボックス（グリッド）の質問です。GridAnswersの
+	// 行ID→カンマ区切りの回答を、entry.<id>.<row>として繰り返し書き込みます。
+	CheckboxGrid
+)
+
+// entryTypeNames は、EntryTypeとJSON上の名前の対応です。
+var entryTypeNames = map[EntryType]string{
+	Text:         "text",
+	Radio:        "radio",
+	Checkbox:     "checkbox",
+	Date:         "date",
+	Time:         "time",
+	Grid:         "grid",
+	CheckboxGrid: "checkbox_grid",
+}
+
+var entryTypeValues = func() map[string]EntryType {
+	values := make(map[string]EntryType, len(entryTypeNames))
+	for t, name := range entryTypeNames {
+		values[name] = t
+	}
+	return values
+}()
+
+// String は、EntryTypeの名前（"text", "checkbox"等）を返します。
+func (t EntryType) String() string {
+	if name, ok := entryTypeNames[t]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalJSON は、EntryTypeを数値ではなく名前としてエンコードします。
+func (t EntryType) MarshalJSON() ([]byte, error) {
+	name, ok := entryTypeNames[t]
+	if !ok {
+		return nil, fmt.Errorf("googleforms: 不明なEntryTypeです: %d", t)
+	}
+	return json.Marshal(name)
+}
+
+// UnmarshalJSON は、"text", "checkbox"等の名前からEntryTypeを復元します。
+func (t *EntryType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	value, ok := entryTypeValues[name]
+	if !ok {
+		return fmt.Errorf("googleforms: 不明なEntryTypeです: %q", name)
+	}
+
+	*t = value
+	return nil
+}
+
+// FormEntry はフォームの入力項目を定義する構造体です。
+//
+// Answer、Answers、GridAnswersの値は、{today}や{uuid}のようなテンプレート
+// プレースホルダーを含められます（resolveTemplatesを参照）。
+type FormEntry struct {
+	QuestionID  string            `json:"question_id"`
+	Type        EntryType         `json:"type,omitempty"`
+	Answer      string            `json:"answer,omitempty"`
+	Answers     []string          `json:"answers,omitempty"`
+	GridAnswers map[string]string `json:"grid_answers,omitempty"`
+	Comment     string            `json:"comment,omitempty"`
+	// Choices は、Discoverによって発見された選択肢の一覧です。URL生成には使われず、
+	// 利用者がAnswer/Answersに設定できる値を確認するための参考情報です。
+	Choices []string `json:"choices,omitempty"`
+}
+
+// ErrNotAViewformURL は、ベースURLがGoogleフォームのviewform URLでない場合に返されるエラーです。
+var ErrNotAViewformURL = fmt.Errorf("ベースURLはGoogleフォームのviewform URLである必要があります")
+
+// ValidateBaseURL は、baseURLがGoogleフォームの公開URL（/viewformを含むURL）であることを検証します。
+func ValidateBaseURL(baseURL string) error {
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil {
+		return fmt.Errorf("URLの解析中にエラーが発生しました: %w", err)
+	}
+
+	if parsedURL.Host != "docs.google.com" || !strings.Contains(parsedURL.Path, "/viewform") {
+		return ErrNotAViewformURL
+	}
+
+	return nil
+}
+
+// CreateAutoFillURL は、GoogleフォームのベースURLと入力項目から自動入力URLを生成します。
+func CreateAutoFillURL(baseURL string, entries []FormEntry) (string, error) {
+	if err := ValidateBaseURL(baseURL); err != nil {
+		return "", err
+	}
+
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("URLの解析中にエラーが発生しました: %w", err)
+	}
+
+	// "usp=sf_link"のクエリストリングを削除
+	queries := parsedURL.Query()
+	queries.Del("usp")
+
+	// "usp=pp_url"のクエリストリングを追加
+	queries.Set("usp", "pp_url")
+
+	// フォームの入力値をクエリストリングに追加
+	for _, entry := range entries {
+		if err := addEntry(queries, entry); err != nil {
+			return "", fmt.Errorf("質問ID %q の処理中にエラーが発生しました: %w", entry.QuestionID, err)
+		}
+	}
+
+	parsedURL.RawQuery = queries.Encode()
+
+	return parsedURL.String(), nil
+}
+
+func addEntry(queries url.Values, entry FormEntry) error {
+	key := fmt.Sprintf("entry.%s", entry.QuestionID)
+
+	switch entry.Type {
+	case Checkbox:
+		for _, answer := range entry.Answers {
+			queries.Add(key, resolveTemplates(answer))
+		}
+
+	case Date:
+		year, month, day, err := splitDate(resolveTemplates(entry.Answer))
+		if err != nil {
+			return err
+		}
+		queries.Set(key+"_year", year)
+		queries.Set(key+"_month", month)
+		queries.Set(key+"_day", day)
+
+	case Time:
+		hour, minute, err := splitTime(resolveTemplates(entry.Answer))
+		if err != nil {
+			return err
+		}
+		queries.Set(key+"_hour", hour)
+		queries.Set(key+"_minute", minute)
+
+	case Grid:
+		for row, answer := range entry.GridAnswers {
+			queries.Set(fmt.Sprintf("%s.%s", key, row), resolveTemplates(answer))
+		}
+
+	case CheckboxGrid:
+		for row, answer := range entry.GridAnswers {
+			rowKey := fmt.Sprintf("%s.%s", key, row)
+			for _, v := range strings.Split(answer, ",") {
+				queries.Add(rowKey, resolveTemplates(v))
+			}
+		}
+
+	default: // Text, Radio
+		queries.Set(key, resolveTemplates(entry.Answer))
+	}
+
+	return nil
+}
+
+func splitDate(value string) (year, month, day string, err error) {
+	parts := strings.Split(value, "-")
+	if len(parts) != 3 {
+		return "", "", "", fmt.Errorf("日付は\"YYYY-MM-DD\"形式である必要があります: %q", value)
+	}
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err != nil {
+			return "", "", "", fmt.Errorf("日付は\"YYYY-MM-DD\"形式である必要があります: %q", value)
+		}
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+func splitTime(value string) (hour, minute string, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("時刻は\"HH:MM\"形式である必要があります: %q", value)
+	}
+	for _, p := range parts {
+		if _, err := strconv.Atoi(p); err != nil {
+			return "", "", fmt.Errorf("時刻は\"HH:MM\"形式である必要があります: %q", value)
+		}
+	}
+	return parts[0], parts[1], nil
+}
+
+// ParseQueryEntries は、"entry.<id>"形式のクエリパラメータをFormEntryのスライスに変換します。
+// GETリクエストのクエリストリングから入力項目を復元する際に使用します。
+//
+// キーの形状から質問の種類を推測します: "_year"/"_month"/"_day"のいずれかがあればDate、
+// "_hour"/"_minute"のいずれかがあればTime（欠けた部分は空文字列のまま組み立てます）、
+// "entry.<id>.<row>"形式ならGrid、
+// 同じキーが複数回繰り返されていればCheckboxとみなします。それ以外はTextです。
+func ParseQueryEntries(values url.Values) []FormEntry {
+	plain := map[string][]string{}
+	grid := map[string]map[string]string{}
+	dates := map[string]*dateParts{}
+	times := map[string]*timeParts{}
+
+	var order []string
+	seen := map[string]bool{}
+	noteQuestionID := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			order = append(order, id)
+		}
+	}
+
+	for key, vals := range values {
+		if !strings.HasPrefix(key, "entry.") || len(vals) == 0 {
+			continue
+		}
+		rest := strings.TrimPrefix(key, "entry.")
+
+		switch {
+		case strings.HasSuffix(rest, "_year"):
+			id := strings.TrimSuffix(rest, "_year")
+			noteQuestionID(id)
+			dateFor(dates, id).year = vals[0]
+
+		case strings.HasSuffix(rest, "_month"):
+			id := strings.TrimSuffix(rest, "_month")
+			noteQuestionID(id)
+			dateFor(dates, id).month = vals[0]
+
+		case strings.HasSuffix(rest, "_day"):
+			id := strings.TrimSuffix(rest, "_day")
+			noteQuestionID(id)
+			dateFor(dates, id).day = vals[0]
+
+		case strings.HasSuffix(rest, "_hour"):
+			id := strings.TrimSuffix(rest, "_hour")
+			noteQuestionID(id)
+			timeFor(times, id).hour = vals[0]
+
+		case strings.HasSuffix(rest, "_minute"):
+			id := strings.TrimSuffix(rest, "_minute")
+			noteQuestionID(id)
+			timeFor(times, id).minute = vals[0]
+
+		case strings.Contains(rest, "."):
+			id, row, _ := strings.Cut(rest, ".")
+			noteQuestionID(id)
+			if grid[id] == nil {
+				grid[id] = map[string]string{}
+			}
+			grid[id][row] = vals[0]
+
+		default:
+			noteQuestionID(rest)
+			plain[rest] = append(plain[rest], vals...)
+		}
+	}
+
+	entries := make([]FormEntry, 0, len(order))
+	for _, id := range order {
+		switch {
+		case dates[id] != nil:
+			d := dates[id]
+			entries = append(entries, FormEntry{
+				QuestionID: id,
+				Type:       Date,
+				Answer:     fmt.Sprintf("%s-%s-%s", d.year, d.month, d.day),
+			})
+
+		case times[id] != nil:
+			tm := times[id]
+			entries = append(entries, FormEntry{
+				QuestionID: id,
+				Type:       Time,
+				Answer:     fmt.Sprintf("%s:%s", tm.hour, tm.minute),
+			})
+
+		case grid[id] != nil:
+			entries = append(entries, FormEntry{
+				QuestionID:  id,
+				Type:        Grid,
+				GridAnswers: grid[id],
+			})
+
+		case len(plain[id]) > 1:
+			entries = append(entries, FormEntry{
+				QuestionID: id,
+				Type:       Checkbox,
+				Answers:    plain[id],
+			})
+
+		default:
+			entries = append(entries, FormEntry{
+				QuestionID: id,
+				Type:       Text,
+				Answer:     plain[id][0],
+			})
+		}
+	}
+
+	return entries
+}
+
+// dateParts は、ParseQueryEntriesが"_year"/"_month"/"_day"サブキーから
+// 日付の質問を組み立てる際に使う、質問ID単位の作業領域です。
+type dateParts struct{ year, month, day string }
+
+// timeParts は、ParseQueryEntriesが"_hour"/"_minute"サブキーから
+// 時刻の質問を組み立てる際に使う、質問ID単位の作業領域です。
+type timeParts struct{ hour, minute string }
+
+func dateFor(dates map[string]*dateParts, id string) *dateParts {
+	d, ok := dates[id]
+	if !ok {
+		d = &dateParts{}
+		dates[id] = d
+	}
+	return d
+}
+
+func timeFor(times map[string]*timeParts, id string) *timeParts {
+	t, ok := times[id]
+	if !ok {
+		t = &timeParts{}
+		times[id] = t
+	}
+	return t
+}
@@ -0,0 +1,196 @@
+package googleforms
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const testBaseURL = "https://docs.google.com/forms/d/e/TEST/viewform?usp=sf_link"
+
+func TestCreateAutoFillURLCheckbox(t *testing.T) {
+	entries := []FormEntry{
+		{QuestionID: "1", Type: Checkbox, Answers: []string{"赤", "青"}},
+	}
+
+	got, err := CreateAutoFillURL(testBaseURL, entries)
+	if err != nil {
+		t.Fatalf("CreateAutoFillURL() error = %v", err)
+	}
+
+	parsed, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("url.Parse() error = %v", err)
+	}
+	values := parsed.Query()["entry.1"]
+	if len(values) != 2 || values[0] != "赤" || values[1] != "青" {
+		t.Errorf("entry.1 = %v, want [赤 青]", values)
+	}
+}
+
+func TestCreateAutoFillURLDateAndTime(t *testing.T) {
+	entries := []FormEntry{
+		{QuestionID: "1", Type: Date, Answer: "2026-07-25"},
+		{QuestionID: "2", Type: Time, Answer: "09:30"},
+	}
+
+	got, err := CreateAutoFillURL(testBaseURL, entries)
+	if err != nil {
+		t.Fatalf("CreateAutoFillURL() error = %v", err)
+	}
+
+	parsed, _ := url.Parse(got)
+	q := parsed.Query()
+	if q.Get("entry.1_year") != "2026" || q.Get("entry.1_month") != "07" || q.Get("entry.1_day") != "25" {
+		t.Errorf("date sub-keys = %v", q)
+	}
+	if q.Get("entry.2_hour") != "09" || q.Get("entry.2_minute") != "30" {
+		t.Errorf("time sub-keys = %v", q)
+	}
+}
+
+func TestCreateAutoFillURLGrid(t *testing.T) {
+	entries := []FormEntry{
+		{QuestionID: "1", Type: Grid, GridAnswers: map[string]string{"行1": "はい"}},
+		{QuestionID: "2", Type: CheckboxGrid, GridAnswers: map[string]string{"行1": "はい,いいえ"}},
+	}
+
+	got, err := CreateAutoFillURL(testBaseURL, entries)
+	if err != nil {
+		t.Fatalf("CreateAutoFillURL() error = %v", err)
+	}
+
+	parsed, _ := url.Parse(got)
+	q := parsed.Query()
+	if q.Get("entry.1.行1") != "はい" {
+		t.Errorf("entry.1.行1 = %q, want はい", q.Get("entry.1.行1"))
+	}
+	if vals := q["entry.2.行1"]; len(vals) != 2 || vals[0] != "はい" || vals[1] != "いいえ" {
+		t.Errorf("entry.2.行1 = %v, want [はい いいえ]", vals)
+	}
+}
+
+func TestResolveTemplates(t *testing.T) {
+	t.Setenv("GOOGLEFORMS_TEST_VAR", "テスト値")
+
+	entries := []FormEntry{
+		{QuestionID: "1", Answer: "{env:GOOGLEFORMS_TEST_VAR}"},
+	}
+
+	got, err := CreateAutoFillURL(testBaseURL, entries)
+	if err != nil {
+		t.Fatalf("CreateAutoFillURL() error = %v", err)
+	}
+	if !strings.Contains(got, url.QueryEscape("テスト値")) {
+		t.Errorf("got = %q, want containing encoded テスト値", got)
+	}
+}
+
+func TestFormEntryJSONRoundTrip(t *testing.T) {
+	entry := FormEntry{
+		QuestionID: "1",
+		Type:       Checkbox,
+		Answers:    []string{"赤", "青"},
+		Comment:    "色",
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"question_id":"1"`) || !strings.Contains(string(data), `"type":"checkbox"`) {
+		t.Errorf("json = %s, want question_id/type fields in snake_case with a named type", data)
+	}
+
+	var got FormEntry
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.Type != Checkbox {
+		t.Errorf("Type = %v, want Checkbox", got.Type)
+	}
+	if len(got.Answers) != 2 || got.Answers[0] != "赤" || got.Answers[1] != "青" {
+		t.Errorf("Answers = %v, want [赤 青]", got.Answers)
+	}
+}
+
+func TestEntryTypeUnmarshalJSONUnknown(t *testing.T) {
+	var t1 EntryType
+	if err := json.Unmarshal([]byte(`"not_a_real_type"`), &t1); err == nil {
+		t.Error("UnmarshalJSON() error = nil, want error for unknown type name")
+	}
+}
+
+func TestParseQueryEntriesText(t *testing.T) {
+	values := url.Values{"entry.1": {"東京"}}
+
+	got := ParseQueryEntries(values)
+	if len(got) != 1 {
+		t.Fatalf("len(got) = %d, want 1", len(got))
+	}
+	if got[0].Type != Text || got[0].Answer != "東京" {
+		t.Errorf("got[0] = %+v, want Text/東京", got[0])
+	}
+}
+
+func TestParseQueryEntriesCheckbox(t *testing.T) {
+	values := url.Values{"entry.5": {"赤", "青"}}
+
+	got := ParseQueryEntries(values)
+	if len(got) != 1 {
+		t.Fatalf("len(got) = %d, want 1", len(got))
+	}
+	if got[0].Type != Checkbox {
+		t.Errorf("Type = %v, want Checkbox", got[0].Type)
+	}
+	if want := []string{"赤", "青"}; len(got[0].Answers) != 2 || got[0].Answers[0] != want[0] || got[0].Answers[1] != want[1] {
+		t.Errorf("Answers = %v, want %v", got[0].Answers, want)
+	}
+}
+
+func TestParseQueryEntriesDate(t *testing.T) {
+	values := url.Values{
+		"entry.1_year":  {"2026"},
+		"entry.1_month": {"07"},
+		"entry.1_day":   {"25"},
+	}
+
+	got := ParseQueryEntries(values)
+	if len(got) != 1 {
+		t.Fatalf("len(got) = %d, want 1", len(got))
+	}
+	if got[0].Type != Date || got[0].Answer != "2026-07-25" {
+		t.Errorf("got[0] = %+v, want Date/2026-07-25", got[0])
+	}
+}
+
+func TestParseQueryEntriesTime(t *testing.T) {
+	values := url.Values{
+		"entry.1_hour":   {"09"},
+		"entry.1_minute": {"30"},
+	}
+
+	got := ParseQueryEntries(values)
+	if len(got) != 1 {
+		t.Fatalf("len(got) = %d, want 1", len(got))
+	}
+	if got[0].Type != Time || got[0].Answer != "09:30" {
+		t.Errorf("got[0] = %+v, want Time/09:30", got[0])
+	}
+}
+
+func TestParseQueryEntriesGrid(t *testing.T) {
+	values := url.Values{"entry.1.行1": {"はい"}}
+
+	got := ParseQueryEntries(values)
+	if len(got) != 1 {
+		t.Fatalf("len(got) = %d, want 1", len(got))
+	}
+	if got[0].Type != Grid {
+		t.Errorf("Type = %v, want Grid", got[0].Type)
+	}
+	if got[0].GridAnswers["行1"] != "はい" {
+		t.Errorf("GridAnswers = %v", got[0].GridAnswers)
+	}
+}
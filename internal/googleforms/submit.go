@@ -0,0 +1,131 @@
+package googleforms
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// browserUserAgent は、GoogleフォームがBotとして弾かないよう、一般的なブラウザを装うUser-Agentです。
+const browserUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+// validationErrorMarker は、Googleフォームが入力値の検証エラーを表示する際に
+// 再描画されるHTML中に現れるクラス名です。
+const validationErrorMarker = "freebirdFormviewerViewItemsItemErrorMessage"
+
+// errorWindowBytes は、検証エラーのマーカーから、対応する質問の entry.<id> を
+// 探索する範囲（バイト数）です。エラーメッセージのdivは、対応する質問要素の
+// 直前に描画されるため、十分な余裕を持たせています。
+const errorWindowBytes = 4000
+
+var entryIDPattern = regexp.MustCompile(`entry\.(\d+)`)
+
+// SubmitValidationError は、Submitで送信した内容をGoogleフォームが検証エラーとして
+// 拒否した場合に返されるエラーです。
+type SubmitValidationError struct {
+	QuestionIDs []string
+}
+
+func (e *SubmitValidationError) Error() string {
+	return fmt.Sprintf("Googleフォームが入力値を拒否しました（質問ID: %s）", strings.Join(e.QuestionIDs, ", "))
+}
+
+// Submit は、baseURL（viewform URL）に対応するformResponse URLへ、entriesの内容を
+// application/x-www-form-urlencoded形式でPOSTします。
+//
+// 成功した場合はresp.Request.URLが確認ページのURLになります。Googleフォームが
+// 入力値を検証エラーとして拒否した場合は、*SubmitValidationError を返します。
+func Submit(baseURL string, entries []FormEntry) (*http.Response, error) {
+	formResponseURL, err := toFormResponseURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	for _, entry := range entries {
+		if err := addEntry(values, entry); err != nil {
+			return nil, fmt.Errorf("質問ID %q の処理中にエラーが発生しました: %w", entry.QuestionID, err)
+		}
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("Cookieジャーの作成に失敗しました: %w", err)
+	}
+	client := &http.Client{Jar: jar}
+
+	req, err := http.NewRequest(http.MethodPost, formResponseURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("リクエストの作成に失敗しました: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", browserUserAgent)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("フォームの送信に失敗しました: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, fmt.Errorf("レスポンスの読み込みに失敗しました: %w", err)
+	}
+
+	if questionIDs := offendingQuestionIDs(body); len(questionIDs) > 0 {
+		return resp, &SubmitValidationError{QuestionIDs: questionIDs}
+	}
+
+	return resp, nil
+}
+
+// toFormResponseURL は、viewform URLを、送信先であるformResponse URLへ変換します。
+func toFormResponseURL(baseURL string) (string, error) {
+	if err := ValidateBaseURL(baseURL); err != nil {
+		return "", err
+	}
+
+	parsedURL, err := url.Parse(baseURL)
+	if err != nil {
+		return "", fmt.Errorf("URLの解析中にエラーが発生しました: %w", err)
+	}
+
+	parsedURL.Path = strings.Replace(parsedURL.Path, "/viewform", "/formResponse", 1)
+	parsedURL.RawQuery = ""
+
+	return parsedURL.String(), nil
+}
+
+// offendingQuestionIDs は、レスポンス本文から検証エラーとなった質問IDを抽出します。
+// Googleフォームは、エラーとなった質問の直前に validationErrorMarker を持つdivを
+// 再描画するため、そのマーカー直後に現れる最初の entry.<id> を対応する質問とみなします。
+func offendingQuestionIDs(body []byte) []string {
+	var questionIDs []string
+	seen := make(map[string]bool)
+
+	marker := []byte(validationErrorMarker)
+	for idx := bytes.Index(body, marker); idx != -1; {
+		window := body[idx:min(idx+errorWindowBytes, len(body))]
+
+		if m := entryIDPattern.FindSubmatch(window); m != nil {
+			questionID := string(m[1])
+			if !seen[questionID] {
+				seen[questionID] = true
+				questionIDs = append(questionIDs, questionID)
+			}
+		}
+
+		rest := bytes.Index(body[idx+len(marker):], marker)
+		if rest == -1 {
+			break
+		}
+		idx = idx + len(marker) + rest
+	}
+
+	return questionIDs
+}
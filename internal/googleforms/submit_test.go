@@ -0,0 +1,42 @@
+package googleforms
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToFormResponseURL(t *testing.T) {
+	got, err := toFormResponseURL("https://docs.google.com/forms/d/e/TEST/viewform?usp=sf_link")
+	if err != nil {
+		t.Fatalf("toFormResponseURL() error = %v", err)
+	}
+	want := "https://docs.google.com/forms/d/e/TEST/formResponse"
+	if got != want {
+		t.Errorf("toFormResponseURL() = %q, want %q", got, want)
+	}
+}
+
+func TestOffendingQuestionIDs(t *testing.T) {
+	body := []byte(`<div class="freebirdFormviewerViewItemsItemErrorMessage">入力してください</div>
+<div data-params="%.@.[null,null,123456789]">...entry.123456789...</div>`)
+
+	got := offendingQuestionIDs(body)
+	if len(got) != 1 || got[0] != "123456789" {
+		t.Errorf("offendingQuestionIDs() = %v, want [123456789]", got)
+	}
+}
+
+func TestOffendingQuestionIDsNoError(t *testing.T) {
+	body := []byte(`<div class="freebirdFormviewerViewResponseConfirmationMessage">回答を記録しました</div>`)
+
+	if got := offendingQuestionIDs(body); len(got) != 0 {
+		t.Errorf("offendingQuestionIDs() = %v, want empty", got)
+	}
+}
+
+func TestSubmitValidationErrorMessage(t *testing.T) {
+	err := &SubmitValidationError{QuestionIDs: []string{"1", "2"}}
+	if !strings.Contains(err.Error(), "1, 2") {
+		t.Errorf("Error() = %q, want containing \"1, 2\"", err.Error())
+	}
+}
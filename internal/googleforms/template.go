@@ -0,0 +1,45 @@
+package googleforms
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// templatePattern は、Answer等に埋め込めるプレースホルダーです。
+//
+//   - {today}      今日の日付（YYYY-MM-DD）
+//   - {today+7d}    7日後の日付（YYYY-MM-DD）、日数は負の数も可
+//   - {now}        現在日時（RFC3339に準ずる秒精度）
+//   - {uuid}       ランダムなUUID
+//   - {env:VAR}    環境変数VARの値
+var templatePattern = regexp.MustCompile(`\{(today(\+(-?\d+)d)?|now|uuid|env:([A-Za-z_][A-Za-z0-9_]*))\}`)
+
+// resolveTemplates は、valueに含まれるテンプレートプレースホルダーをURL生成時点の値に置き換えます。
+// プレースホルダーを含まない文字列はそのまま返します。
+func resolveTemplates(value string) string {
+	return templatePattern.ReplaceAllStringFunc(value, func(match string) string {
+		groups := templatePattern.FindStringSubmatch(match)
+
+		switch {
+		case groups[1] == "now":
+			return time.Now().Format("2006-01-02T15:04:05")
+
+		case groups[1] == "uuid":
+			return uuid.New().String()
+
+		case groups[4] != "":
+			return os.Getenv(groups[4])
+
+		default: // "today" or "today+Nd"
+			days := 0
+			if groups[3] != "" {
+				days, _ = strconv.Atoi(groups[3])
+			}
+			return time.Now().AddDate(0, 0, days).Format("2006-01-02")
+		}
+	})
+}